@@ -5,14 +5,18 @@ import (
 	"net/http"
 
 	"github.com/nofendian17/libapi/response"
+	"github.com/nofendian17/libapi/response/binding"
 )
 
 func main() {
-	// Example HTTP server demonstrating libapi usage
-	http.HandleFunc("/api/success", successHandler)
-	http.HandleFunc("/api/error", errorHandler)
-	http.HandleFunc("/api/validation", validationHandler)
-	http.HandleFunc("/api/paginated", paginatedHandler)
+	// Example HTTP server demonstrating libapi usage.
+	// response.TraceIDMiddleware resolves a trace ID for every request
+	// (from traceparent, X-Trace-ID/X-Request-ID, or a generated UUIDv7)
+	// so handlers never need to call WithTraceID themselves.
+	http.Handle("/api/success", response.TraceIDMiddleware(http.HandlerFunc(successHandler)))
+	http.Handle("/api/error", response.TraceIDMiddleware(http.HandlerFunc(errorHandler)))
+	http.Handle("/api/validation", response.TraceIDMiddleware(http.HandlerFunc(validationHandler)))
+	http.Handle("/api/paginated", response.TraceIDMiddleware(http.HandlerFunc(paginatedHandler)))
 
 	log.Println("Server starting on :8080")
 	log.Fatal(http.ListenAndServe(":8080", nil))
@@ -20,9 +24,6 @@ func main() {
 
 // successHandler demonstrates a basic success response
 func successHandler(w http.ResponseWriter, r *http.Request) {
-	// Add trace ID to context
-	ctx := response.WithTraceID(r.Context(), "trace-123")
-
 	// Create response data
 	data := map[string]any{
 		"message": "Operation successful",
@@ -32,8 +33,8 @@ func successHandler(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	// Create API response with trace ID
-	apiResp := response.NewAPIResponse(ctx)
+	// Create API response with trace ID from the request context
+	apiResp := response.NewAPIResponse(r.Context())
 	apiResp.Status = response.StatusSuccess
 	apiResp.Data = data
 
@@ -56,12 +57,7 @@ func errorHandler(w http.ResponseWriter, r *http.Request) {
 			"INTERNAL_ERROR",
 			"Database connection failed",
 		)
-
-		// Add trace ID if available
-		if traceID := r.Header.Get("X-Trace-ID"); traceID != "" {
-			ctx := response.WithTraceID(r.Context(), traceID)
-			resp.TraceID = response.NewAPIResponse(ctx).TraceID
-		}
+		resp.TraceID = response.NewAPIResponse(r.Context()).TraceID
 
 		response.RespondJSON(w, http.StatusInternalServerError, resp)
 		return
@@ -90,11 +86,10 @@ func validationHandler(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	resp := response.NewValidationErrorResponse(details)
-
-	// Add trace ID
-	ctx := response.WithTraceID(r.Context(), "validation-trace-456")
-	resp.TraceID = response.NewAPIResponse(ctx).TraceID
+	// binding.ValidationErrorResponse resolves its top-level message from
+	// the request's Accept-Language header instead of a fixed locale.
+	resp := binding.ValidationErrorResponse(r, details)
+	resp.TraceID = response.NewAPIResponse(r.Context()).TraceID
 
 	response.RespondJSON(w, http.StatusUnprocessableEntity, resp)
 }
@@ -116,10 +111,7 @@ func paginatedHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := response.NewSuccessResponse(items, meta)
-
-	// Add trace ID
-	ctx := response.WithTraceID(r.Context(), "pagination-trace-789")
-	resp.TraceID = response.NewAPIResponse(ctx).TraceID
+	resp.TraceID = response.NewAPIResponse(r.Context()).TraceID
 
 	response.RespondJSON(w, http.StatusOK, resp)
 }
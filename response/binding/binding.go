@@ -0,0 +1,232 @@
+// Package binding decodes and validates incoming request data (JSON bodies,
+// query parameters and path parameters) into a destination struct, producing
+// response.ValidationError values ready for response.NewValidationErrorResponse.
+package binding
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/nofendian17/libapi/response"
+)
+
+// Validator validates a struct and returns nil, a validator.ValidationErrors,
+// or any other error. It is pluggable so callers can swap in their own
+// validation library instead of go-playground/validator.
+type Validator interface {
+	Struct(v any) error
+}
+
+// CurrentValidator is the Validator used by BindJSON and BindQuery. Replace
+// it (e.g. in an init function) to use a different validation library.
+var CurrentValidator Validator = newDefaultValidator()
+
+// defaultValidator wraps *validator.Validate and reports struct fields by
+// their json tag name rather than their Go field name, so validation errors
+// line up with the wire format the client actually sent.
+type defaultValidator struct {
+	validate *validator.Validate
+}
+
+func newDefaultValidator() *defaultValidator {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" || name == "" {
+			return field.Name
+		}
+		return name
+	})
+	return &defaultValidator{validate: v}
+}
+
+func (d *defaultValidator) Struct(v any) error {
+	return d.validate.Struct(v)
+}
+
+// BindJSON decodes the request body as JSON into dst and validates it using
+// CurrentValidator. On success it returns nil. On failure it returns the
+// populated field-level validation errors, ready to pass to
+// response.NewValidationErrorResponse.
+//
+// Example:
+//
+//	var req CreateUserRequest
+//	if errs := binding.BindJSON(r, &req); errs != nil {
+//	    response.RespondJSON(w, http.StatusUnprocessableEntity, response.NewValidationErrorResponse(errs))
+//	    return
+//	}
+func BindJSON(r *http.Request, dst any) []response.ValidationError {
+	defer r.Body.Close()
+
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return []response.ValidationError{{Field: "body", Message: "request body is not valid JSON"}}
+	}
+
+	return validateStruct(r, dst)
+}
+
+// BindQuery populates dst from the request's query string and validates it
+// using CurrentValidator. Fields are matched by their `json` tag, the same
+// way BindJSON matches body fields. Only string, bool, int/uint and float
+// kinds are supported.
+//
+// Example:
+//
+//	var req ListUsersRequest
+//	if errs := binding.BindQuery(r, &req); errs != nil {
+//	    response.RespondJSON(w, http.StatusUnprocessableEntity, response.NewValidationErrorResponse(errs))
+//	    return
+//	}
+func BindQuery(r *http.Request, dst any) []response.ValidationError {
+	if err := decodeQuery(r.URL.Query(), dst); err != nil {
+		return []response.ValidationError{{Field: "query", Message: err.Error()}}
+	}
+
+	return validateStruct(r, dst)
+}
+
+// BindPath populates dst from the route's path parameters and validates it
+// using CurrentValidator, the same way BindQuery validates query parameters.
+// Fields are matched by their `json` tag. Only string, bool, int/uint and
+// float kinds are supported.
+//
+// This package has no router of its own and stdlib net/http versions before
+// Go 1.22 have no path-parameter extraction at all, so BindPath takes the
+// extracted params as a plain map rather than pulling them from r itself.
+// Callers fill that map from whatever router they use:
+//
+//	// net/http's ServeMux on Go 1.22+:
+//	params := map[string]string{"id": r.PathValue("id")}
+//
+//	// a third-party router, e.g. gorilla/mux:
+//	params := mux.Vars(r)
+//
+//	var req GetUserRequest
+//	if errs := binding.BindPath(r, params, &req); errs != nil {
+//	    response.RespondJSON(w, http.StatusUnprocessableEntity, response.NewValidationErrorResponse(errs))
+//	    return
+//	}
+func BindPath(r *http.Request, params map[string]string, dst any) []response.ValidationError {
+	if err := decodePath(params, dst); err != nil {
+		return []response.ValidationError{{Field: "path", Message: err.Error()}}
+	}
+
+	return validateStruct(r, dst)
+}
+
+func validateStruct(r *http.Request, dst any) []response.ValidationError {
+	err := CurrentValidator.Struct(dst)
+	if err == nil {
+		return nil
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return []response.ValidationError{{Field: "", Message: err.Error()}}
+	}
+
+	locale := response.LocaleFromRequest(r)
+	details := make([]response.ValidationError, 0, len(verrs))
+	for _, fe := range verrs {
+		details = append(details, response.ValidationError{
+			Field:   fe.Field(),
+			Message: response.Translate(locale, fe),
+		})
+	}
+	return details
+}
+
+func decodeQuery(values map[string][]string, dst any) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Struct {
+		return errors.New("binding: BindQuery destination must be a pointer to a struct")
+	}
+
+	elem := ptr.Elem()
+	t := elem.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+
+		raw, ok := values[name]
+		if !ok || len(raw) == 0 {
+			continue
+		}
+
+		if err := setFieldValue(elem.Field(i), raw[0]); err != nil {
+			return errors.New("binding: invalid value for query parameter " + name)
+		}
+	}
+	return nil
+}
+
+func decodePath(values map[string]string, dst any) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Struct {
+		return errors.New("binding: BindPath destination must be a pointer to a struct")
+	}
+
+	elem := ptr.Elem()
+	t := elem.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+
+		raw, ok := values[name]
+		if !ok {
+			continue
+		}
+
+		if err := setFieldValue(elem.Field(i), raw); err != nil {
+			return errors.New("binding: invalid value for path parameter " + name)
+		}
+	}
+	return nil
+}
+
+func setFieldValue(field reflect.Value, raw string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(v)
+	default:
+		return errors.New("binding: unsupported field kind " + field.Kind().String())
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package binding
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type createUserRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Age   int    `json:"age" validate:"min=18"`
+}
+
+func TestBindJSON(t *testing.T) {
+	t.Run("valid body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"email":"a@example.com","age":21}`))
+		var dst createUserRequest
+		if errs := BindJSON(req, &dst); errs != nil {
+			t.Fatalf("BindJSON() errs = %v, want nil", errs)
+		}
+		if dst.Email != "a@example.com" || dst.Age != 21 {
+			t.Errorf("BindJSON() dst = %+v, want decoded fields", dst)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{`))
+		var dst createUserRequest
+		errs := BindJSON(req, &dst)
+		if len(errs) != 1 || errs[0].Field != "body" {
+			t.Errorf("BindJSON() errs = %v, want single body error", errs)
+		}
+	})
+
+	t.Run("validation failure uses json field names", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"email":"not-an-email","age":5}`))
+		var dst createUserRequest
+		errs := BindJSON(req, &dst)
+		if len(errs) != 2 {
+			t.Fatalf("BindJSON() errs = %v, want 2 errors", errs)
+		}
+		if errs[0].Field != "email" || errs[1].Field != "age" {
+			t.Errorf("BindJSON() errs = %v, want fields email and age", errs)
+		}
+	})
+
+	t.Run("validation failure translated to Indonesian", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{}`))
+		req.Header.Set("Accept-Language", "id-ID,id;q=0.9")
+		var dst createUserRequest
+		errs := BindJSON(req, &dst)
+		if len(errs) == 0 || errs[0].Message != "email wajib diisi" {
+			t.Errorf("BindJSON() errs = %v, want Indonesian required message", errs)
+		}
+	})
+}
+
+func TestBindQuery(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/?email=a@example.com&age=21", nil)
+	var dst createUserRequest
+	if errs := BindQuery(req, &dst); errs != nil {
+		t.Fatalf("BindQuery() errs = %v, want nil", errs)
+	}
+	if dst.Email != "a@example.com" || dst.Age != 21 {
+		t.Errorf("BindQuery() dst = %+v, want decoded fields", dst)
+	}
+}
+
+func TestBindPath(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	var dst createUserRequest
+	params := map[string]string{"email": "a@example.com", "age": "21"}
+	if errs := BindPath(req, params, &dst); errs != nil {
+		t.Fatalf("BindPath() errs = %v, want nil", errs)
+	}
+	if dst.Email != "a@example.com" || dst.Age != 21 {
+		t.Errorf("BindPath() dst = %+v, want decoded fields", dst)
+	}
+}
+
+func TestBindPathValidationFailure(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	var dst createUserRequest
+	errs := BindPath(req, map[string]string{"email": "not-an-email", "age": "5"}, &dst)
+	if len(errs) != 2 {
+		t.Fatalf("BindPath() errs = %v, want 2 errors", errs)
+	}
+	if errs[0].Field != "email" || errs[1].Field != "age" {
+		t.Errorf("BindPath() errs = %v, want fields email and age", errs)
+	}
+}
+
+func TestDecodePathRejectsNonStructPointer(t *testing.T) {
+	var dst string
+	err := decodePath(map[string]string{}, &dst)
+	if err == nil {
+		t.Error("decodePath() error = nil, want error for non-struct destination")
+	}
+}
+
+func TestLocaleFromRequest(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: "en"},
+		{header: "id-ID,id;q=0.9,en;q=0.8", want: "id"},
+		{header: "fr-FR", want: "en"},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", tt.header)
+		if got := LocaleFromRequest(req); got != tt.want {
+			t.Errorf("LocaleFromRequest(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestValidationErrorResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "indonesian", header: "id", want: "Data yang dikirim tidak valid."},
+		{name: "english default", header: "", want: "The submitted data is invalid."},
+		{name: "unsupported locale falls back to english", header: "fr-FR", want: "The submitted data is invalid."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Accept-Language", tt.header)
+
+			resp := ValidationErrorResponse(req, nil)
+			if resp.Error.Message != tt.want {
+				t.Errorf("ValidationErrorResponse() Message = %v, want %v", resp.Error.Message, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeQueryRejectsNonStructPointer(t *testing.T) {
+	var dst string
+	err := decodeQuery(url.Values{}, &dst)
+	if err == nil {
+		t.Error("decodeQuery() error = nil, want error for non-struct destination")
+	}
+}
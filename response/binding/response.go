@@ -0,0 +1,22 @@
+package binding
+
+import (
+	"net/http"
+
+	"github.com/nofendian17/libapi/response"
+)
+
+// ValidationErrorResponse builds the standard validation error envelope for
+// details, resolving its top-level message from the request's
+// Accept-Language header via response.NewLocalizedValidationErrorResponse
+// instead of a single hardcoded locale.
+//
+// Example:
+//
+//	if errs := binding.BindJSON(r, &req); errs != nil {
+//	    response.RespondJSON(w, http.StatusUnprocessableEntity, binding.ValidationErrorResponse(r, errs))
+//	    return
+//	}
+func ValidationErrorResponse(r *http.Request, details []response.ValidationError) response.APIResponse {
+	return response.NewLocalizedValidationErrorResponse(response.LocaleFromRequest(r), details)
+}
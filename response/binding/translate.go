@@ -0,0 +1,15 @@
+package binding
+
+import (
+	"net/http"
+
+	"github.com/nofendian17/libapi/response"
+)
+
+// LocaleFromRequest resolves the preferred locale for validation messages
+// from the request's Accept-Language header. It forwards to
+// response.LocaleFromRequest so binding and response.FromError resolve
+// locale the same way.
+func LocaleFromRequest(r *http.Request) string {
+	return response.LocaleFromRequest(r)
+}
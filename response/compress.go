@@ -0,0 +1,35 @@
+package response
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// wrapEncoding inspects acceptEncoding and, if the client accepts gzip or
+// deflate, wraps w in the matching compressing io.Writer and sets the
+// Content-Encoding header. It returns the writer to encode into and a
+// close function that must be called after encoding to flush any buffered
+// compressed data. When no supported encoding is requested, it returns w
+// unchanged and a no-op close function.
+func wrapEncoding(w http.ResponseWriter, acceptEncoding string) (io.Writer, func() error) {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		encoding := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		switch encoding {
+		case "gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			return gz, gz.Close
+		case "deflate":
+			w.Header().Set("Content-Encoding", "deflate")
+			fw, err := flate.NewWriter(w, flate.DefaultCompression)
+			if err != nil {
+				return w, func() error { return nil }
+			}
+			return fw, fw.Close
+		}
+	}
+	return w, func() error { return nil }
+}
@@ -0,0 +1,125 @@
+package response
+
+import (
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Encoder serializes a payload onto w for a single media type.
+type Encoder interface {
+	// ContentType is the media type this Encoder produces, e.g.
+	// "application/json".
+	ContentType() string
+	// Encode writes payload to w in this Encoder's format.
+	Encode(w io.Writer, payload any) error
+}
+
+// Responder negotiates a response's wire format from the request's Accept
+// header across a set of registered Encoders, and transparently compresses
+// the body when the request's Accept-Encoding allows it. The APIResponse
+// envelope itself stays canonical; only its serialization varies.
+type Responder struct {
+	encoders map[string]Encoder
+	order    []string
+}
+
+// DefaultResponder is the package-level Responder used by RegisterEncoder
+// and by any helper that wants content negotiation without constructing its
+// own Responder.
+var DefaultResponder = NewResponder()
+
+// NewResponder creates a Responder pre-registered with JSON, MessagePack
+// and Protobuf encoders.
+func NewResponder() *Responder {
+	r := &Responder{encoders: make(map[string]Encoder)}
+	r.RegisterEncoder(jsonEncoder{}.ContentType(), jsonEncoder{})
+	r.RegisterEncoder(msgpackEncoder{}.ContentType(), msgpackEncoder{})
+	r.RegisterEncoder(protobufEncoder{}.ContentType(), protobufEncoder{})
+	return r
+}
+
+// RegisterEncoder adds or replaces the Encoder used for mediaType.
+func (r *Responder) RegisterEncoder(mediaType string, enc Encoder) {
+	if _, exists := r.encoders[mediaType]; !exists {
+		r.order = append(r.order, mediaType)
+	}
+	r.encoders[mediaType] = enc
+}
+
+// RegisterEncoder registers enc for mediaType on DefaultResponder.
+//
+// Example:
+//
+//	response.RegisterEncoder("application/x-protobuf", myProtobufEncoder{})
+func RegisterEncoder(mediaType string, enc Encoder) {
+	DefaultResponder.RegisterEncoder(mediaType, enc)
+}
+
+// Respond negotiates an Encoder from r's Accept header, applies gzip/deflate
+// compression when r's Accept-Encoding allows it, writes statusCode, and
+// encodes payload. It falls back to JSON when the client accepts a format
+// with no registered Encoder.
+//
+// Example:
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    resp := response.NewSuccessResponse(user, nil)
+//	    if err := response.DefaultResponder.Respond(w, r, http.StatusOK, resp); err != nil {
+//	        log.Printf("failed to write response: %v", err)
+//	    }
+//	}
+func (r *Responder) Respond(w http.ResponseWriter, req *http.Request, statusCode int, payload any) error {
+	mediaType, enc := r.negotiate(req.Header.Get("Accept"))
+	w.Header().Set("Content-Type", mediaType)
+
+	writer, closeWriter := wrapEncoding(w, req.Header.Get("Accept-Encoding"))
+
+	w.WriteHeader(statusCode)
+	encodeErr := enc.Encode(writer, payload)
+	closeErr := closeWriter()
+	if encodeErr != nil {
+		return encodeErr
+	}
+	return closeErr
+}
+
+func (r *Responder) negotiate(accept string) (string, Encoder) {
+	jsonType, jsonEnc := jsonEncoder{}.ContentType(), Encoder(jsonEncoder{})
+	if enc, ok := r.encoders[jsonType]; ok {
+		jsonEnc = enc
+	}
+
+	if accept == "" {
+		return jsonType, jsonEnc
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		if mediaType == "" || mediaType == "*/*" {
+			continue
+		}
+		if enc, ok := r.encoders[mediaType]; ok {
+			return mediaType, enc
+		}
+		if typ, ok := strings.CutSuffix(mediaType, "/*"); ok {
+			if mediaType, enc, ok := r.firstRegisteredOfType(typ); ok {
+				return mediaType, enc
+			}
+		}
+	}
+
+	return jsonType, jsonEnc
+}
+
+// firstRegisteredOfType returns the first Encoder registered (in
+// registration order) whose media type's top-level type matches typ, for
+// resolving wildcard Accept values like "application/*".
+func (r *Responder) firstRegisteredOfType(typ string) (string, Encoder, bool) {
+	for _, mediaType := range r.order {
+		if before, _, ok := strings.Cut(mediaType, "/"); ok && before == typ {
+			return mediaType, r.encoders[mediaType], true
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,121 @@
+package response
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestResponderRespondJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+
+	resp := NewSuccessResponse(map[string]string{"key": "value"}, nil)
+	if err := DefaultResponder.Respond(w, req, http.StatusOK, resp); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Respond() Content-Type = %v, want application/json", ct)
+	}
+
+	var body APIResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal body: %v", err)
+	}
+	if body.Status != StatusSuccess {
+		t.Errorf("Respond() Status = %v, want %v", body.Status, StatusSuccess)
+	}
+}
+
+func TestResponderRespondMsgpack(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/x-msgpack")
+	w := httptest.NewRecorder()
+
+	resp := NewSuccessResponse(map[string]string{"key": "value"}, nil)
+	if err := DefaultResponder.Respond(w, req, http.StatusOK, resp); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-msgpack" {
+		t.Errorf("Respond() Content-Type = %v, want application/x-msgpack", ct)
+	}
+
+	var body APIResponse
+	if err := msgpack.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal msgpack body: %v", err)
+	}
+	if body.Status != StatusSuccess {
+		t.Errorf("Respond() Status = %v, want %v", body.Status, StatusSuccess)
+	}
+}
+
+func TestResponderRespondGzip(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+
+	resp := NewSuccessResponse(map[string]string{"key": "value"}, nil)
+	if err := DefaultResponder.Respond(w, req, http.StatusOK, resp); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+
+	if ce := w.Header().Get("Content-Encoding"); ce != "gzip" {
+		t.Errorf("Respond() Content-Encoding = %v, want gzip", ce)
+	}
+
+	gz, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatalf("failed to open gzip reader: %v", err)
+	}
+	defer gz.Close()
+
+	raw, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("failed to read gzip body: %v", err)
+	}
+
+	var body APIResponse
+	if err := json.Unmarshal(raw, &body); err != nil {
+		t.Fatalf("failed to unmarshal decompressed body: %v", err)
+	}
+	if body.Status != StatusSuccess {
+		t.Errorf("Respond() Status = %v, want %v", body.Status, StatusSuccess)
+	}
+}
+
+func TestResponderUnknownAcceptFallsBackToJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+
+	if err := DefaultResponder.Respond(w, req, http.StatusOK, NewSuccessResponse(nil, nil)); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Respond() Content-Type = %v, want application/json fallback", ct)
+	}
+}
+
+func TestResponderWildcardAcceptUsesFirstRegisteredOfType(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/*")
+	w := httptest.NewRecorder()
+
+	if err := DefaultResponder.Respond(w, req, http.StatusOK, NewSuccessResponse(nil, nil)); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+
+	// application/json is registered first among the "application/*"
+	// encoders, so it should win the wildcard match.
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Respond() Content-Type = %v, want application/json for application/* wildcard", ct)
+	}
+}
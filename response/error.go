@@ -13,3 +13,9 @@ type APIError struct {
 	Message    string            `json:"message"`           // Human-readable error message
 	Details    []ValidationError `json:"details,omitempty"` // Detailed validation errors
 }
+
+// Error implements the error interface so *APIError can be returned and
+// matched with errors.As/errors.Is like any other error.
+func (e *APIError) Error() string {
+	return e.Message
+}
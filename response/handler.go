@@ -0,0 +1,142 @@
+package response
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// JSONResponse bundles everything Wrap needs to write an HTTP response:
+// the status code, the JSON-encodable body, and any extra headers to set
+// before the status line is written.
+type JSONResponse struct {
+	Code    int
+	Body    any
+	Headers http.Header
+}
+
+// Handler is a handler-return-style HTTP handler: instead of writing to a
+// http.ResponseWriter directly, it returns the JSONResponse to write. Wrap
+// adapts it to the standard http.HandlerFunc signature.
+type Handler func(*http.Request) JSONResponse
+
+// StatusCoder is implemented by errors that know which HTTP status they
+// should map to. FromError checks for it after the well-known error types.
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// Wrap adapts a Handler to http.HandlerFunc. It sets the JSON Content-Type,
+// writes the returned status code and body, recovers from panics (mapping
+// them through FromError), and fills in the response trace ID from the
+// request context or headers when the handler didn't already set one.
+//
+// Example:
+//
+//	http.Handle("/users/123", response.Wrap(func(r *http.Request) response.JSONResponse {
+//	    user, err := store.GetUser(r.Context(), "123")
+//	    if err != nil {
+//	        resp := response.FromError(r, err)
+//	        return resp
+//	    }
+//	    return response.JSONResponse{Code: http.StatusOK, Body: response.NewSuccessResponse(user, nil)}
+//	}))
+func Wrap(h Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				writeJSONResponse(w, r, FromError(r, fmt.Errorf("panic: %v", rec)))
+			}
+		}()
+		writeJSONResponse(w, r, h(r))
+	}
+}
+
+func writeJSONResponse(w http.ResponseWriter, r *http.Request, resp JSONResponse) {
+	header := w.Header()
+	for key, values := range resp.Headers {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+	header.Set("Content-Type", "application/json")
+
+	if ar, ok := resp.Body.(APIResponse); ok && ar.TraceID == "" {
+		ar.TraceID = traceIDFromRequest(r)
+		resp.Body = ar
+	} else if ar, ok := resp.Body.(*APIResponse); ok && ar.TraceID == "" {
+		ar.TraceID = traceIDFromRequest(r)
+	}
+
+	w.WriteHeader(resp.Code)
+	_ = json.NewEncoder(w).Encode(resp.Body)
+}
+
+// traceIDFromRequest resolves the trace ID to stamp on an outgoing response,
+// preferring the context value set by WithTraceID and falling back to the
+// common request-id headers clients and proxies already send.
+func traceIDFromRequest(r *http.Request) string {
+	if id, ok := r.Context().Value(traceIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	if id := r.Header.Get("X-Trace-ID"); id != "" {
+		return id
+	}
+	return r.Header.Get("X-Request-ID")
+}
+
+// FromError maps an error into the standard APIResponse envelope, choosing
+// the HTTP status and error code based on the error's concrete type:
+//
+//   - *APIError is used as-is.
+//   - validator.ValidationErrors becomes a VALIDATION_FAILED response with
+//     one ValidationError per failed field, translated via Translate and
+//     localized from r's Accept-Language header, the same way the binding
+//     package localizes its own validation errors.
+//   - context.DeadlineExceeded maps to 504 Gateway Timeout.
+//   - sql.ErrNoRows maps to 404 Not Found.
+//   - a StatusCoder error maps to its reported status code, with a generic
+//     message; like every other branch here, the error's own text is never
+//     sent to the client.
+//   - anything else maps to 500 Internal Server Error.
+func FromError(r *http.Request, err error) JSONResponse {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return JSONResponse{Code: apiErr.HTTPStatus, Body: APIResponse{Status: StatusError, Error: apiErr}}
+	}
+
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		locale := LocaleFromRequest(r)
+		details := make([]ValidationError, 0, len(verrs))
+		for _, fe := range verrs {
+			details = append(details, ValidationError{Field: fe.Field(), Message: Translate(locale, fe)})
+		}
+		resp := NewLocalizedValidationErrorResponse(locale, details)
+		return JSONResponse{Code: resp.Error.HTTPStatus, Body: resp}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		resp := NewErrorResponse(http.StatusGatewayTimeout, "DEADLINE_EXCEEDED", "the request took too long to process")
+		return JSONResponse{Code: resp.Error.HTTPStatus, Body: resp}
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		resp := NewErrorResponse(http.StatusNotFound, "NOT_FOUND", "the requested resource was not found")
+		return JSONResponse{Code: resp.Error.HTTPStatus, Body: resp}
+	}
+
+	var coder StatusCoder
+	if errors.As(err, &coder) {
+		resp := NewErrorResponse(coder.StatusCode(), "ERROR", "the request could not be completed")
+		return JSONResponse{Code: resp.Error.HTTPStatus, Body: resp}
+	}
+
+	resp := NewErrorResponse(http.StatusInternalServerError, "INTERNAL_ERROR", "an unexpected error occurred")
+	return JSONResponse{Code: resp.Error.HTTPStatus, Body: resp}
+}
@@ -0,0 +1,169 @@
+package response
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestWrap(t *testing.T) {
+	h := Wrap(func(r *http.Request) JSONResponse {
+		return JSONResponse{Code: http.StatusOK, Body: NewSuccessResponse(map[string]string{"ok": "true"}, nil)}
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	h(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrap() status code = %v, want %v", w.Code, http.StatusOK)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Wrap() Content-Type = %v, want application/json", ct)
+	}
+}
+
+func TestWrapPropagatesTraceIDFromHeader(t *testing.T) {
+	h := Wrap(func(r *http.Request) JSONResponse {
+		return JSONResponse{Code: http.StatusOK, Body: NewSuccessResponse(nil, nil)}
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Trace-ID", "trace-abc")
+	w := httptest.NewRecorder()
+	h(w, req)
+
+	var body APIResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal body: %v", err)
+	}
+	if body.TraceID != "trace-abc" {
+		t.Errorf("Wrap() TraceID = %v, want trace-abc", body.TraceID)
+	}
+}
+
+func TestWrapRecoversFromPanic(t *testing.T) {
+	h := Wrap(func(r *http.Request) JSONResponse {
+		panic("boom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	h(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Wrap() status code after panic = %v, want %v", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{
+			name:     "api error",
+			err:      &APIError{HTTPStatus: http.StatusConflict, Code: "CONFLICT", Message: "already exists"},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:     "sql no rows",
+			err:      sql.ErrNoRows,
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "wrapped sql no rows",
+			err:      errors.New("lookup user: " + sql.ErrNoRows.Error()),
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "unknown error",
+			err:      errors.New("boom"),
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := FromError(req, tt.err)
+			if resp.Code != tt.wantCode {
+				t.Errorf("FromError() Code = %v, want %v", resp.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+type statusCodedError struct {
+	status int
+	msg    string
+}
+
+func (e *statusCodedError) Error() string   { return e.msg }
+func (e *statusCodedError) StatusCode() int { return e.status }
+
+func TestFromErrorStatusCoderDoesNotLeakErrorText(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	err := &statusCodedError{status: http.StatusBadGateway, msg: "upstream dial tcp 10.0.0.5:5432: connection refused"}
+
+	resp := FromError(req, err)
+
+	if resp.Code != http.StatusBadGateway {
+		t.Errorf("FromError() Code = %v, want %v", resp.Code, http.StatusBadGateway)
+	}
+
+	body, ok := resp.Body.(APIResponse)
+	if !ok {
+		t.Fatalf("FromError() Body type = %T, want APIResponse", resp.Body)
+	}
+	if strings.Contains(body.Error.Message, "10.0.0.5") || body.Error.Message == err.Error() {
+		t.Errorf("FromError() Message = %q, leaked raw error text", body.Error.Message)
+	}
+}
+
+type fromErrorValidationTarget struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+func TestFromErrorValidationErrorsUsesTranslatedJSONFieldNames(t *testing.T) {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" || name == "" {
+			return field.Name
+		}
+		return name
+	})
+
+	err := v.Struct(fromErrorValidationTarget{Email: ""})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "id")
+
+	resp := FromError(req, err)
+
+	body, ok := resp.Body.(APIResponse)
+	if !ok {
+		t.Fatalf("FromError() Body type = %T, want APIResponse", resp.Body)
+	}
+	if len(body.Error.Details) != 1 {
+		t.Fatalf("FromError() Details = %v, want 1 entry", body.Error.Details)
+	}
+	if body.Error.Details[0].Field != "email" {
+		t.Errorf("FromError() Details[0].Field = %v, want json tag name \"email\"", body.Error.Details[0].Field)
+	}
+	if body.Error.Details[0].Message != "email wajib diisi" {
+		t.Errorf("FromError() Details[0].Message = %v, want translated Indonesian message, not the raw validator error", body.Error.Details[0].Message)
+	}
+}
@@ -1,4 +1,4 @@
-package v1
+package response
 
 import (
 	"encoding/json"
@@ -57,6 +57,8 @@ func NewErrorResponse(httpStatus int, code string, message string) APIResponse {
 
 // NewValidationErrorResponse creates a validation error response with detailed field-level errors.
 // It automatically sets HTTP status to 422 Unprocessable Entity and error code to "VALIDATION_FAILED".
+// Its top-level message defaults to English; call NewLocalizedValidationErrorResponse with a locale
+// derived from the request's Accept-Language header (see LocaleFromRequest) when that matters.
 //
 // Example:
 //
@@ -67,13 +69,5 @@ func NewErrorResponse(httpStatus int, code string, message string) APIResponse {
 //	resp := NewValidationErrorResponse(details)
 //	RespondJSON(w, http.StatusUnprocessableEntity, resp)
 func NewValidationErrorResponse(details []ValidationError) APIResponse {
-	return APIResponse{
-		Status: StatusError,
-		Error: &APIError{
-			HTTPStatus: http.StatusUnprocessableEntity,
-			Code:       "VALIDATION_FAILED",
-			Message:    "Data yang dikirim tidak valid.",
-			Details:    details,
-		},
-	}
+	return NewLocalizedValidationErrorResponse("en", details)
 }
@@ -0,0 +1,108 @@
+package response
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TranslatorFunc turns a single validator.FieldError into a human-readable
+// message for the given locale (a lowercase BCP 47 primary language subtag,
+// e.g. "en" or "id"). Assign it to Translate to customize or extend the
+// messages FromError and the binding package produce.
+type TranslatorFunc func(locale string, fe validator.FieldError) string
+
+// Translate resolves a validator.FieldError to a human-readable message. It
+// is exported as a variable so callers can override it, for example to add
+// locales or reword messages, without forking this package.
+var Translate TranslatorFunc = defaultTranslate
+
+// fieldMessages maps locale -> validator tag -> message template. Each
+// template takes the field name and, where relevant, the tag parameter.
+var fieldMessages = map[string]map[string]string{
+	"en": {
+		"required": "%s is required",
+		"email":    "%s must be a valid email address",
+		"min":      "%s must be at least %s",
+		"max":      "%s must be at most %s",
+		"oneof":    "%s must be one of [%s]",
+		"default":  "%s is invalid",
+	},
+	"id": {
+		"required": "%s wajib diisi",
+		"email":    "%s harus berupa alamat email yang valid",
+		"min":      "%s minimal %s",
+		"max":      "%s maksimal %s",
+		"oneof":    "%s harus salah satu dari [%s]",
+		"default":  "%s tidak valid",
+	},
+}
+
+func defaultTranslate(locale string, fe validator.FieldError) string {
+	messages, ok := fieldMessages[locale]
+	if !ok {
+		messages = fieldMessages["en"]
+	}
+
+	template, ok := messages[fe.Tag()]
+	if !ok {
+		template = messages["default"]
+	}
+
+	if strings.Count(template, "%s") == 2 {
+		return fmt.Sprintf(template, fe.Field(), fe.Param())
+	}
+	return fmt.Sprintf(template, fe.Field())
+}
+
+// LocaleFromRequest resolves the preferred locale for validation messages
+// from the request's Accept-Language header, falling back to "en" when the
+// header is absent or names a locale with no messages registered.
+func LocaleFromRequest(r *http.Request) string {
+	header := r.Header.Get(http.CanonicalHeaderKey("Accept-Language"))
+	for _, part := range strings.Split(header, ",") {
+		tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		if tag == "" {
+			continue
+		}
+		primary := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+		if _, ok := fieldMessages[primary]; ok {
+			return primary
+		}
+	}
+	return "en"
+}
+
+// validationMessages maps locale -> the top-level message used for
+// NewLocalizedValidationErrorResponse's envelope.
+var validationMessages = map[string]string{
+	"en": "The submitted data is invalid.",
+	"id": "Data yang dikirim tidak valid.",
+}
+
+// NewLocalizedValidationErrorResponse is NewValidationErrorResponse with its
+// top-level message resolved for locale instead of a single fixed locale.
+// Use LocaleFromRequest(r) to derive locale from a request's
+// Accept-Language header.
+//
+// Example:
+//
+//	resp := response.NewLocalizedValidationErrorResponse(response.LocaleFromRequest(r), details)
+//	response.RespondJSON(w, http.StatusUnprocessableEntity, resp)
+func NewLocalizedValidationErrorResponse(locale string, details []ValidationError) APIResponse {
+	message, ok := validationMessages[locale]
+	if !ok {
+		message = validationMessages["en"]
+	}
+	return APIResponse{
+		Status: StatusError,
+		Error: &APIError{
+			HTTPStatus: http.StatusUnprocessableEntity,
+			Code:       "VALIDATION_FAILED",
+			Message:    message,
+			Details:    details,
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package response
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewValidationErrorResponseDefaultsToEnglish(t *testing.T) {
+	resp := NewValidationErrorResponse(nil)
+	if resp.Error.Message != "The submitted data is invalid." {
+		t.Errorf("NewValidationErrorResponse() Message = %v, want English default", resp.Error.Message)
+	}
+}
+
+func TestNewLocalizedValidationErrorResponse(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "en", want: "The submitted data is invalid."},
+		{locale: "id", want: "Data yang dikirim tidak valid."},
+		{locale: "fr", want: "The submitted data is invalid."},
+	}
+
+	for _, tt := range tests {
+		resp := NewLocalizedValidationErrorResponse(tt.locale, nil)
+		if resp.Error.Message != tt.want {
+			t.Errorf("NewLocalizedValidationErrorResponse(%q) Message = %v, want %v", tt.locale, resp.Error.Message, tt.want)
+		}
+	}
+}
+
+func TestLocaleFromRequest(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: "en"},
+		{header: "id-ID,id;q=0.9,en;q=0.8", want: "id"},
+		{header: "fr-FR", want: "en"},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", tt.header)
+		if got := LocaleFromRequest(req); got != tt.want {
+			t.Errorf("LocaleFromRequest(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
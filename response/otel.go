@@ -0,0 +1,27 @@
+package response
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FromOTel builds an APIResponse the same way NewAPIResponse does, but
+// prefers the trace ID of the active OpenTelemetry span in ctx over any
+// value stashed by WithTraceID. Use it in handlers instrumented with OTel
+// so the response's trace ID always matches the span clients can look up
+// in tracing backends, without calling WithTraceID manually.
+//
+// Example:
+//
+//	ctx, span := tracer.Start(r.Context(), "GetUser")
+//	defer span.End()
+//	resp := response.FromOTel(ctx)
+//	resp.Data = user
+func FromOTel(ctx context.Context) *APIResponse {
+	resp := NewAPIResponse(ctx)
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		resp.TraceID = span.TraceID().String()
+	}
+	return resp
+}
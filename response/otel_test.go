@@ -0,0 +1,55 @@
+package response
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestFromOTelWithValidSpan(t *testing.T) {
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	if err != nil {
+		t.Fatalf("failed to build trace ID: %v", err)
+	}
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	if err != nil {
+		t.Fatalf("failed to build span ID: %v", err)
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	resp := FromOTel(ctx)
+
+	if resp.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("FromOTel() TraceID = %v, want span trace ID", resp.TraceID)
+	}
+}
+
+func TestFromOTelWithoutSpanFallsBackToContextTraceID(t *testing.T) {
+	ctx := WithTraceID(context.Background(), "manual-trace-id")
+
+	resp := FromOTel(ctx)
+
+	if resp.TraceID != "manual-trace-id" {
+		t.Errorf("FromOTel() TraceID = %v, want value set via WithTraceID", resp.TraceID)
+	}
+}
+
+func TestFromOTelWithInvalidSpanContext(t *testing.T) {
+	// An empty SpanContext is invalid, so FromOTel should not override the
+	// trace ID it got from NewAPIResponse.
+	ctx := WithTraceID(context.Background(), "manual-trace-id")
+	ctx = trace.ContextWithSpanContext(ctx, trace.SpanContext{})
+
+	resp := FromOTel(ctx)
+
+	if resp.TraceID != "manual-trace-id" {
+		t.Errorf("FromOTel() TraceID = %v, want unchanged value for invalid span context", resp.TraceID)
+	}
+}
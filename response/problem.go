@@ -0,0 +1,89 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type written by RespondProblem, per RFC 7807.
+const ProblemContentType = "application/problem+json"
+
+// Problem represents an RFC 7807 "Problem Details for HTTP APIs" document.
+// Type, Title, Status, Detail and Instance are the members defined by the
+// RFC; Extensions carries any additional members a producer wants to attach
+// (e.g. a "violations" or "errors" array for validation failures).
+type Problem struct {
+	Type       string         `json:"type"`
+	Title      string         `json:"title"`
+	Status     int            `json:"status"`
+	Detail     string         `json:"detail,omitempty"`
+	Instance   string         `json:"instance,omitempty"`
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON flattens Extensions alongside the standard RFC 7807 members.
+func (p Problem) MarshalJSON() ([]byte, error) {
+	out := make(map[string]any, len(p.Extensions)+5)
+	for k, v := range p.Extensions {
+		out[k] = v
+	}
+	out["type"] = p.Type
+	out["title"] = p.Title
+	out["status"] = p.Status
+	if p.Detail != "" {
+		out["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		out["instance"] = p.Instance
+	}
+	return json.Marshal(out)
+}
+
+// NewProblemResponse creates a Problem with the given HTTP status, type URI,
+// title and detail. If typ is empty it defaults to "about:blank", per RFC
+// 7807 §4.2.
+//
+// Example:
+//
+//	p := NewProblemResponse(http.StatusUnprocessableEntity, "", "Validation Failed", "one or more fields are invalid")
+//	RespondProblem(w, p)
+func NewProblemResponse(status int, typ, title, detail string) *Problem {
+	if typ == "" {
+		typ = "about:blank"
+	}
+	return &Problem{
+		Type:   typ,
+		Title:  title,
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// RespondProblem writes p to w as application/problem+json, using p.Status
+// as the HTTP status code.
+//
+// Example:
+//
+//	RespondProblem(w, NewProblemResponse(http.StatusNotFound, "", "Not Found", "user 42 does not exist"))
+func RespondProblem(w http.ResponseWriter, p *Problem) error {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
+
+// ToProblem converts an APIError into its RFC 7807 Problem Details
+// representation, so handlers built around the bespoke error envelope can
+// opt into Problem Details without changing how errors are constructed.
+// The error Code becomes the problem Type, Message becomes Title, and any
+// field-level Details are carried as the "errors" extension member.
+func (e *APIError) ToProblem() *Problem {
+	p := &Problem{
+		Type:   e.Code,
+		Title:  e.Message,
+		Status: e.HTTPStatus,
+	}
+	if len(e.Details) > 0 {
+		p.Extensions = map[string]any{"errors": e.Details}
+	}
+	return p
+}
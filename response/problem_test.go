@@ -0,0 +1,75 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewProblemResponse(t *testing.T) {
+	p := NewProblemResponse(http.StatusNotFound, "", "Not Found", "user 42 does not exist")
+
+	if p.Type != "about:blank" {
+		t.Errorf("NewProblemResponse() Type = %v, want about:blank", p.Type)
+	}
+	if p.Status != http.StatusNotFound {
+		t.Errorf("NewProblemResponse() Status = %v, want %v", p.Status, http.StatusNotFound)
+	}
+	if p.Title != "Not Found" {
+		t.Errorf("NewProblemResponse() Title = %v, want Not Found", p.Title)
+	}
+	if p.Detail != "user 42 does not exist" {
+		t.Errorf("NewProblemResponse() Detail = %v, want user 42 does not exist", p.Detail)
+	}
+}
+
+func TestRespondProblem(t *testing.T) {
+	w := httptest.NewRecorder()
+	p := NewProblemResponse(http.StatusUnprocessableEntity, "https://example.com/probs/validation", "Validation Failed", "email is required")
+
+	if err := RespondProblem(w, p); err != nil {
+		t.Fatalf("RespondProblem() error = %v", err)
+	}
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("RespondProblem() status code = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != ProblemContentType {
+		t.Errorf("RespondProblem() Content-Type = %v, want %v", ct, ProblemContentType)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal body: %v", err)
+	}
+	if body["title"] != "Validation Failed" {
+		t.Errorf("RespondProblem() body title = %v, want Validation Failed", body["title"])
+	}
+}
+
+func TestAPIErrorToProblem(t *testing.T) {
+	apiErr := &APIError{
+		HTTPStatus: http.StatusUnprocessableEntity,
+		Code:       "VALIDATION_FAILED",
+		Message:    "Data yang dikirim tidak valid.",
+		Details: []ValidationError{
+			{Field: "email", Message: "Invalid email format"},
+		},
+	}
+
+	p := apiErr.ToProblem()
+
+	if p.Type != "VALIDATION_FAILED" {
+		t.Errorf("ToProblem() Type = %v, want VALIDATION_FAILED", p.Type)
+	}
+	if p.Status != http.StatusUnprocessableEntity {
+		t.Errorf("ToProblem() Status = %v, want %v", p.Status, http.StatusUnprocessableEntity)
+	}
+
+	errs, ok := p.Extensions["errors"].([]ValidationError)
+	if !ok || len(errs) != 1 {
+		t.Errorf("ToProblem() Extensions[\"errors\"] = %v, want one ValidationError", p.Extensions["errors"])
+	}
+}
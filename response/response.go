@@ -1,4 +1,4 @@
-package v1
+package response
 
 import (
 	"context"
@@ -14,11 +14,22 @@ const (
 // It uses an unexported type to avoid key collisions.
 type traceIDKey struct{}
 
-// Metadata represents pagination metadata for API responses.
+// Metadata represents pagination metadata for API responses. A response
+// uses whichever pagination model fits it: page-based (Page/PerPage/
+// TotalItems), cursor-based (NextCursor/PrevCursor/HasMore), or offset-based
+// (Offset/ReturnedCount/TotalCount) — the unused fields are omitted.
 type Metadata struct {
 	Page       int `json:"page,omitempty"`        // Current page number (1-based)
 	PerPage    int `json:"per_page,omitempty"`    // Number of items per page
 	TotalItems int `json:"total_items,omitempty"` // Total number of items across all pages
+
+	NextCursor string `json:"next_cursor,omitempty"` // Opaque cursor for the next page, if any
+	PrevCursor string `json:"prev_cursor,omitempty"` // Opaque cursor for the previous page, if any
+	HasMore    bool   `json:"has_more,omitempty"`    // Whether more items are available after NextCursor
+
+	Offset        int `json:"offset,omitempty"`         // Offset of the first item in this response
+	ReturnedCount int `json:"returned_count,omitempty"` // Number of items in this response
+	TotalCount    int `json:"total_count,omitempty"`    // Total number of items across all pages, if known
 }
 
 // APIResponse represents a standard API response structure.
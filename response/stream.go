@@ -0,0 +1,106 @@
+package response
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// RespondJSONStream writes the standard success envelope to w, streaming
+// items from the items channel into the "data" array one at a time instead
+// of materializing the full slice in memory. meta, if non-nil, is written
+// as the envelope's trailing "meta" member once items is drained. If w
+// implements http.Flusher, the response is flushed after each item so
+// clients can start consuming before the full result set is produced.
+//
+// Example:
+//
+//	items := make(chan any)
+//	go func() {
+//	    defer close(items)
+//	    for _, row := range rows {
+//	        items <- row
+//	    }
+//	}()
+//	err := response.RespondJSONStream(w, http.StatusOK, &response.Metadata{HasMore: false}, items)
+func RespondJSONStream(w http.ResponseWriter, statusCode int, meta *Metadata, items <-chan any) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	flusher, _ := w.(http.Flusher)
+
+	if _, err := io.WriteString(w, `{"status":"`+StatusSuccess+`","data":[`); err != nil {
+		return err
+	}
+
+	first := true
+	for item := range items {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		b, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return err
+	}
+
+	if meta != nil {
+		metaBytes, err := json.Marshal(meta)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, `,"meta":`); err != nil {
+			return err
+		}
+		if _, err := w.Write(metaBytes); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "}")
+	if flusher != nil {
+		flusher.Flush()
+	}
+	return err
+}
+
+// RespondNDJSON streams items to w as newline-delimited JSON
+// (application/x-ndjson), one item per line, flushing after each line when
+// w implements http.Flusher. Unlike RespondJSONStream it writes no envelope
+// — it's meant for very large exports where clients process the stream
+// record by record rather than waiting for a single JSON document.
+//
+// Example:
+//
+//	err := response.RespondNDJSON(w, http.StatusOK, items)
+func RespondNDJSON(w http.ResponseWriter, statusCode int, items <-chan any) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(statusCode)
+
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+
+	for item := range items {
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	return nil
+}
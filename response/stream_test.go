@@ -0,0 +1,95 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRespondJSONStream(t *testing.T) {
+	items := make(chan any, 3)
+	items <- map[string]int{"id": 1}
+	items <- map[string]int{"id": 2}
+	close(items)
+
+	w := httptest.NewRecorder()
+	meta := &Metadata{NextCursor: "abc", HasMore: true}
+
+	if err := RespondJSONStream(w, http.StatusOK, meta, items); err != nil {
+		t.Fatalf("RespondJSONStream() error = %v", err)
+	}
+
+	if w.Code != http.StatusOK {
+		t.Errorf("RespondJSONStream() status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var body struct {
+		Status string           `json:"status"`
+		Data   []map[string]int `json:"data"`
+		Meta   Metadata         `json:"meta"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal body: %v", err)
+	}
+
+	if body.Status != StatusSuccess {
+		t.Errorf("RespondJSONStream() status = %v, want %v", body.Status, StatusSuccess)
+	}
+	if len(body.Data) != 2 {
+		t.Fatalf("RespondJSONStream() data length = %v, want 2", len(body.Data))
+	}
+	if body.Meta.NextCursor != "abc" || !body.Meta.HasMore {
+		t.Errorf("RespondJSONStream() meta = %+v, want NextCursor=abc HasMore=true", body.Meta)
+	}
+}
+
+func TestRespondJSONStreamEmpty(t *testing.T) {
+	items := make(chan any)
+	close(items)
+
+	w := httptest.NewRecorder()
+	if err := RespondJSONStream(w, http.StatusOK, nil, items); err != nil {
+		t.Fatalf("RespondJSONStream() error = %v", err)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal body: %v", err)
+	}
+	if data, ok := body["data"].([]any); !ok || len(data) != 0 {
+		t.Errorf("RespondJSONStream() data = %v, want empty array", body["data"])
+	}
+	if _, ok := body["meta"]; ok {
+		t.Error("RespondJSONStream() meta should be omitted when nil")
+	}
+}
+
+func TestRespondNDJSON(t *testing.T) {
+	items := make(chan any, 2)
+	items <- map[string]int{"id": 1}
+	items <- map[string]int{"id": 2}
+	close(items)
+
+	w := httptest.NewRecorder()
+	if err := RespondNDJSON(w, http.StatusOK, items); err != nil {
+		t.Fatalf("RespondNDJSON() error = %v", err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("RespondNDJSON() Content-Type = %v, want application/x-ndjson", ct)
+	}
+
+	lines := 0
+	dec := json.NewDecoder(w.Body)
+	for {
+		var v map[string]int
+		if err := dec.Decode(&v); err != nil {
+			break
+		}
+		lines++
+	}
+	if lines != 2 {
+		t.Errorf("RespondNDJSON() lines = %v, want 2", lines)
+	}
+}
@@ -0,0 +1,115 @@
+package response
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"hash/fnv"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// traceparentVersion is the only version defined by the W3C Trace Context
+// spec as of this writing.
+const traceparentVersion = "00"
+
+// TraceIDMiddleware resolves a trace ID for every request and stashes it in
+// the request context under the same key WithTraceID uses, so
+// NewAPIResponse picks it up without handlers having to call WithTraceID
+// themselves. It resolves the ID, in order, from:
+//
+//  1. a W3C "traceparent" header
+//  2. an "X-Trace-ID" or "X-Request-ID" header
+//  3. a freshly generated UUIDv7
+//
+// The resolved trace ID (and, for a fresh ID, a synthesized traceparent) is
+// written back on the response so downstream services and the client can
+// correlate against it.
+//
+// Example:
+//
+//	http.Handle("/api/users", response.TraceIDMiddleware(http.HandlerFunc(listUsers)))
+func TraceIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceID, spanID := parseTraceparent(r.Header.Get("traceparent"))
+
+		if traceID == "" {
+			traceID = r.Header.Get("X-Trace-ID")
+		}
+		if traceID == "" {
+			traceID = r.Header.Get("X-Request-ID")
+		}
+
+		var traceHex string
+		if traceID == "" {
+			id, err := uuid.NewV7()
+			if err != nil {
+				id = uuid.New()
+			}
+			traceID = id.String()
+			traceHex = hex.EncodeToString(id[:])
+		} else {
+			traceHex = traceparentHex(traceID)
+		}
+
+		if spanID == "" {
+			spanID = newSpanID()
+		}
+
+		w.Header().Set("X-Trace-ID", traceID)
+		w.Header().Set("traceparent", strings.Join([]string{traceparentVersion, traceHex, spanID, "01"}, "-"))
+
+		ctx := WithTraceID(r.Context(), traceID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// parseTraceparent extracts the trace-id and parent-id fields from a W3C
+// "traceparent" header (version-traceid-parentid-flags). It returns empty
+// strings if header is absent or malformed.
+func parseTraceparent(header string) (traceID, spanID string) {
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 {
+		return "", ""
+	}
+	if len(parts[1]) != 32 || !isHex(parts[1]) {
+		return "", ""
+	}
+	if len(parts[2]) != 16 || !isHex(parts[2]) {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+// traceparentHex derives a 32-character hex trace-id suitable for the
+// traceparent header from an ID that came from X-Trace-ID/X-Request-ID
+// rather than an existing traceparent. Those headers carry arbitrary
+// strings, so the ID is hashed down to the fixed 16-byte width the W3C
+// format requires.
+func traceparentHex(traceID string) string {
+	if len(traceID) == 32 && isHex(traceID) {
+		return strings.ToLower(traceID)
+	}
+
+	h := fnv.New128a()
+	_, _ = h.Write([]byte(traceID))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func newSpanID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "0000000000000000"
+	}
+	return hex.EncodeToString(b)
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')) {
+			return false
+		}
+	}
+	return true
+}
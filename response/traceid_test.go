@@ -0,0 +1,70 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTraceIDMiddlewareGeneratesTraceID(t *testing.T) {
+	var gotTraceID string
+	h := TraceIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceID = NewAPIResponse(r.Context()).TraceID
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if gotTraceID == "" {
+		t.Error("TraceIDMiddleware() did not stash a generated trace ID in the context")
+	}
+	if w.Header().Get("X-Trace-ID") != gotTraceID {
+		t.Errorf("TraceIDMiddleware() X-Trace-ID header = %v, want %v", w.Header().Get("X-Trace-ID"), gotTraceID)
+	}
+	if w.Header().Get("traceparent") == "" {
+		t.Error("TraceIDMiddleware() did not set a traceparent header")
+	}
+}
+
+func TestTraceIDMiddlewareUsesTraceparentHeader(t *testing.T) {
+	var gotTraceID string
+	h := TraceIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceID = NewAPIResponse(r.Context()).TraceID
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if gotTraceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("TraceIDMiddleware() trace ID = %v, want traceparent trace-id", gotTraceID)
+	}
+	if w.Header().Get("traceparent") != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("TraceIDMiddleware() traceparent = %v, want echoed header", w.Header().Get("traceparent"))
+	}
+}
+
+func TestTraceIDMiddlewareFallsBackToXTraceID(t *testing.T) {
+	var gotTraceID string
+	h := TraceIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceID = NewAPIResponse(r.Context()).TraceID
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Trace-ID", "custom-trace-id")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if gotTraceID != "custom-trace-id" {
+		t.Errorf("TraceIDMiddleware() trace ID = %v, want custom-trace-id", gotTraceID)
+	}
+}
+
+func TestParseTraceparentRejectsMalformedHeader(t *testing.T) {
+	traceID, spanID := parseTraceparent("not-a-traceparent-header")
+	if traceID != "" || spanID != "" {
+		t.Errorf("parseTraceparent() = (%v, %v), want empty strings for malformed header", traceID, spanID)
+	}
+}